@@ -0,0 +1,58 @@
+package minima
+
+/**
+ * @info An alice-style middleware constructor, wrapping a Handler with another
+ */
+type Constructor func(Handler) Handler
+
+/**
+ * @info An ordered, composable stack of Constructors
+ * @property {[]Constructor} [constructors] The constructors to apply, outermost first
+ */
+type Chain struct {
+	constructors []Constructor
+}
+
+/**
+ * @info Makes a new Chain out of the given constructors, applied outermost first
+ * @param {...Constructor} [cs] The constructors making up the chain
+ * @returns {Chain}
+ */
+func NewChain(cs ...Constructor) Chain {
+	constructors := make([]Constructor, len(cs))
+	copy(constructors, cs)
+	return Chain{constructors: constructors}
+}
+
+/**
+ * @info Returns a new Chain extending this one with additional constructors
+ * @param {...Constructor} [cs] The constructors to append
+ * @returns {Chain}
+ */
+func (c Chain) Append(cs ...Constructor) Chain {
+	newCons := make([]Constructor, 0, len(c.constructors)+len(cs))
+	newCons = append(newCons, c.constructors...)
+	newCons = append(newCons, cs...)
+	return Chain{constructors: newCons}
+}
+
+/**
+ * @info Wraps h with every constructor in the chain, outermost first (m1(m2(m3(h))))
+ * @param {Handler} [h] The final handler the chain should terminate in
+ * @returns {Handler}
+ */
+func (c Chain) Then(h Handler) Handler {
+	for i := len(c.constructors) - 1; i >= 0; i-- {
+		h = c.constructors[i](h)
+	}
+	return h
+}
+
+/**
+ * @info Same as Then, but takes a plain func(*Response, *Request) instead of a Handler
+ * @param {func(*Response, *Request)} [fn] The final handler the chain should terminate in
+ * @returns {Handler}
+ */
+func (c Chain) ThenFunc(fn func(res *Response, req *Request)) Handler {
+	return c.Then(Handler(fn))
+}
@@ -0,0 +1,75 @@
+package minima
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChainThenRunsConstructorsOutermostFirst(t *testing.T) {
+	var order []string
+
+	trace := func(name string) Constructor {
+		return func(next Handler) Handler {
+			return func(res *Response, req *Request) {
+				order = append(order, name)
+				next(res, req)
+			}
+		}
+	}
+
+	chain := NewChain(trace("first"), trace("second"))
+	handler := chain.ThenFunc(func(res *Response, req *Request) {
+		order = append(order, "handler")
+		res.Send("ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler(response(rec, req, nil), request(req))
+
+	if want := []string{"first", "second", "handler"}; !equalStrings(order, want) {
+		t.Fatalf("expected run order %v, got %v", want, order)
+	}
+}
+
+func TestChainAppendExtendsWithoutMutatingOriginal(t *testing.T) {
+	var order []string
+
+	trace := func(name string) Constructor {
+		return func(next Handler) Handler {
+			return func(res *Response, req *Request) {
+				order = append(order, name)
+				next(res, req)
+			}
+		}
+	}
+
+	base := NewChain(trace("base"))
+	extended := base.Append(trace("extra"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	base.ThenFunc(func(res *Response, req *Request) {})(response(rec, req, nil), request(req))
+	if want := []string{"base"}; !equalStrings(order, want) {
+		t.Fatalf("expected base chain to run %v, got %v", want, order)
+	}
+
+	order = nil
+	extended.ThenFunc(func(res *Response, req *Request) {})(response(rec, req, nil), request(req))
+	if want := []string{"base", "extra"}; !equalStrings(order, want) {
+		t.Fatalf("expected extended chain to run %v, got %v", want, order)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
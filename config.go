@@ -0,0 +1,34 @@
+package minima
+
+import (
+	"net"
+	"net/http"
+)
+
+/**
+ * @info The core config file for middlewares and router instances
+ * @property {[]Handler} [Middleware] Minima middleware to inject into every instance using this config
+ * @property {[]http.HandlerFunc} [HttpHandler] Raw net/http middleware to inject into every instance using this config
+ * @property {[]*Router} [Router] Routers to mount onto every instance using this config
+ * @property {Binder} [Binder] Decodes request data for typed Handle handlers; defaults to JSON
+ * @property {func(*Response, *Request, error)} [ErrorHandler] Routes errors returned by HandlerE handlers
+ * @property {bool} [DisableRecover] Disables the default panic-recovery middleware
+ * @property {[]*net.IPNet} [TrustedProxies] CIDRs whose X-Forwarded-For/X-Real-IP headers Request.RealIP trusts
+ */
+type Config struct {
+	Middleware     []Handler
+	HttpHandler    []http.HandlerFunc
+	Router         []*Router
+	Binder         Binder
+	ErrorHandler   func(res *Response, req *Request, err error)
+	DisableRecover bool
+	TrustedProxies []*net.IPNet
+}
+
+/**
+ * @info Makes a new empty Config instance
+ * @returns {*Config}
+ */
+func NewConfig() *Config {
+	return &Config{}
+}
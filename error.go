@@ -0,0 +1,57 @@
+package minima
+
+import (
+	"log"
+	"net/http"
+	"runtime/debug"
+)
+
+/**
+ * @info An error carrying the HTTP status it should be reported with
+ * @property {int} [Code] The HTTP status code
+ * @property {string} [Message] The message reported to the client
+ */
+type HTTPError struct {
+	Code    int
+	Message string
+}
+
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+func (e *HTTPError) StatusCode() int {
+	return e.Code
+}
+
+/**
+ * @info A handler that may fail; its error is routed through the instance's configured ErrorHandler
+ */
+type HandlerE func(res *Response, req *Request) error
+
+/**
+ * @info Builds a Constructor that recovers a panicking handler and replies with a 500
+ * @returns {Constructor}
+ */
+func Recover() Constructor {
+	return func(next Handler) Handler {
+		return func(res *Response, req *Request) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("minima: recovered from panic: %v\n%s", r, debug.Stack())
+					res.Status(http.StatusInternalServerError)
+					res.JSON(`{"error":{"code":500,"message":"Internal Server Error"}}`)
+				}
+			}()
+			next(res, req)
+		}
+	}
+}
+
+func (m *Minima) handleError(res *Response, req *Request, err error) {
+	if m.Config != nil && m.Config.ErrorHandler != nil {
+		m.Config.ErrorHandler(res, req, err)
+		return
+	}
+	writeHandleError(res, err)
+}
@@ -0,0 +1,80 @@
+package minima
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRecoverWrapsTheComposedChain(t *testing.T) {
+	app := New()
+	app.UseChain(NewChain(func(next Handler) Handler {
+		return func(res *Response, req *Request) {
+			panic("boom")
+		}
+	}))
+	app.Get("/", func(res *Response, req *Request) {
+		res.Send("ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("ServeHTTP should recover panics from the middleware chain, got: %v", r)
+		}
+	}()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status 500, got %d", rec.Code)
+	}
+}
+
+func TestHandleErrorRoutesThroughCustomErrorHandler(t *testing.T) {
+	app := New()
+	called := false
+	app.Config.ErrorHandler = func(res *Response, req *Request, err error) {
+		called = true
+		res.Status(http.StatusTeapot).Send(err.Error())
+	}
+	app.Handle(http.MethodGet, "/", HandlerE(func(res *Response, req *Request) error {
+		return &HTTPError{Code: http.StatusBadRequest, Message: "boom"}
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if !called {
+		t.Fatal("expected the custom ErrorHandler to run instead of writeHandleError")
+	}
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected status from the custom ErrorHandler, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != "boom" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
+func TestRecoverWrapsTheNotFoundHandler(t *testing.T) {
+	app := New()
+	app.NotFound(func(res *Response, req *Request) {
+		panic("boom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/missing", nil)
+	rec := httptest.NewRecorder()
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("ServeHTTP should recover panics from the NotFound handler, got: %v", r)
+		}
+	}()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status 500, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,146 @@
+package minima
+
+import "strings"
+
+/**
+ * @info A set of routes sharing a path prefix and middleware stack
+ * @property {*Router} [router] The router routes are ultimately registered against
+ * @property {string} [prefix] The path prefix joined onto every route registered on this group
+ * @property {Chain} [chain] The middleware, baked in at registration time, wrapping every route on this group
+ */
+type Group struct {
+	router *Router
+	prefix string
+	chain  Chain
+}
+
+func newGroup(router *Router, prefix string, chain Chain, mw ...Handler) *Group {
+	constructors := make([]Constructor, len(mw))
+	for i, h := range mw {
+		h := h
+		constructors[i] = func(next Handler) Handler {
+			return func(res *Response, req *Request) {
+				h(res, req)
+				// A middleware that has already written a response (e.g. a 401
+				// from an auth check) is short-circuiting the chain; don't run
+				// the protected handler on top of it.
+				if res.written {
+					return
+				}
+				next(res, req)
+			}
+		}
+	}
+	return &Group{router: router, prefix: prefix, chain: chain.Append(constructors...)}
+}
+
+func (g *Group) join(path string) string {
+	return strings.TrimRight(g.prefix, "/") + "/" + strings.TrimLeft(path, "/")
+}
+
+/**
+ * @info Adds route with Get method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Get(path string, handler Handler) *Group {
+	g.router.Get(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Adds route with Post method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Post(path string, handler Handler) *Group {
+	g.router.Post(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Adds route with Put method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Put(path string, handler Handler) *Group {
+	g.router.Put(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Adds route with Patch method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Patch(path string, handler Handler) *Group {
+	g.router.Patch(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Adds route with Delete method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Delete(path string, handler Handler) *Group {
+	g.router.Delete(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Adds route with Head method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Head(path string, handler Handler) *Group {
+	g.router.Head(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Adds route with Options method, prefixed and wrapped with the group's middleware
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {*Group}
+ */
+func (g *Group) Options(path string, handler Handler) *Group {
+	g.router.Options(g.join(path), g.chain.Then(handler))
+	return g
+}
+
+/**
+ * @info Makes a nested Group, joining prefix onto the parent's and inheriting its middleware
+ * @param {string} [prefix] The path prefix for the nested group
+ * @param {...Handler} [mw] Additional middleware run before every route on the nested group
+ * @returns {*Group}
+ */
+func (g *Group) Group(prefix string, mw ...Handler) *Group {
+	return newGroup(g.router, g.join(prefix), g.chain, mw...)
+}
+
+/**
+ * @info Declares a route group sharing prefix and middleware on the router
+ * @param {string} [prefix] The path prefix for every route registered on the group
+ * @param {...Handler} [mw] Middleware run, in order, before every route on the group
+ * @returns {*Group}
+ */
+func (r *Router) Group(prefix string, mw ...Handler) *Group {
+	return newGroup(r, prefix, Chain{}, mw...)
+}
+
+/**
+ * @info Declares a route group sharing prefix and middleware on the Minima instance
+ * @param {string} [prefix] The path prefix for every route registered on the group
+ * @param {...Handler} [mw] Middleware run, in order, before every route on the group
+ * @returns {*Group}
+ */
+func (m *Minima) Group(prefix string, mw ...Handler) *Group {
+	return newGroup(m.router, prefix, Chain{}, mw...)
+}
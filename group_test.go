@@ -0,0 +1,159 @@
+package minima
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupMiddlewareShortCircuits(t *testing.T) {
+	app := New()
+	handlerRan := false
+
+	auth := func(res *Response, req *Request) {
+		res.Status(http.StatusUnauthorized).Send(`{"error":"unauthorized"}`)
+	}
+
+	app.Group("/api/v1", auth).Get("/secret", func(res *Response, req *Request) {
+		handlerRan = true
+		res.Send("top secret data")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/secret", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if handlerRan {
+		t.Fatal("protected handler ran after middleware already wrote a response")
+	}
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != `{"error":"unauthorized"}` {
+		t.Fatalf("expected only the middleware's body, got %q", body)
+	}
+}
+
+func TestGroupMiddlewareRunsHandlerWhenNotShortCircuited(t *testing.T) {
+	app := New()
+	var order []string
+
+	logMw := func(res *Response, req *Request) {
+		order = append(order, "middleware")
+	}
+
+	app.Group("/api/v1", logMw).Get("/ping", func(res *Response, req *Request) {
+		order = append(order, "handler")
+		res.Send("pong")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/ping", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Body.String() != "pong" {
+		t.Fatalf("expected handler body %q, got %q", "pong", rec.Body.String())
+	}
+	if len(order) != 2 || order[0] != "middleware" || order[1] != "handler" {
+		t.Fatalf("expected middleware then handler to run, got %v", order)
+	}
+}
+
+func TestRouterGroupRegistersOntoTheRouter(t *testing.T) {
+	router := NewRouter()
+	var order []string
+
+	logMw := func(res *Response, req *Request) {
+		order = append(order, "middleware")
+	}
+
+	router.Group("/api", logMw).Get("/ping", func(res *Response, req *Request) {
+		order = append(order, "handler")
+		res.Send("pong")
+	})
+
+	app := New()
+	app.UseRouter(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/ping", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Body.String() != "pong" {
+		t.Fatalf("expected body %q, got %q", "pong", rec.Body.String())
+	}
+	if want := []string{"middleware", "handler"}; !equalStrings(order, want) {
+		t.Fatalf("expected %v, got %v", want, order)
+	}
+}
+
+func TestNestedGroupInheritsPrefixAndMiddleware(t *testing.T) {
+	app := New()
+	var order []string
+
+	parentMw := func(res *Response, req *Request) {
+		order = append(order, "parent")
+	}
+	childMw := func(res *Response, req *Request) {
+		order = append(order, "child")
+	}
+
+	app.Group("/api", parentMw).Group("/v1", childMw).Get("/ping", func(res *Response, req *Request) {
+		order = append(order, "handler")
+		res.Send("pong")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/ping", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != "pong" {
+		t.Fatalf("expected body %q, got %q", "pong", body)
+	}
+	if want := []string{"parent", "child", "handler"}; !equalStrings(order, want) {
+		t.Fatalf("expected nested group to run %v, got %v", want, order)
+	}
+}
+
+func TestNestedGroupShortCircuitOnlyAffectsItsOwnBranch(t *testing.T) {
+	app := New()
+	handlerRan := false
+
+	auth := func(res *Response, req *Request) {
+		res.Status(http.StatusUnauthorized).Send(`{"error":"unauthorized"}`)
+	}
+
+	api := app.Group("/api")
+	api.Group("/admin", auth).Get("/secret", func(res *Response, req *Request) {
+		handlerRan = true
+		res.Send("top secret data")
+	})
+	api.Get("/public", func(res *Response, req *Request) {
+		res.Send("anyone can see this")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/secret", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if handlerRan {
+		t.Fatal("protected handler ran after the nested group's middleware already wrote a response")
+	}
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/public", nil)
+	rec = httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected the sibling route to be unaffected, got status %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != "anyone can see this" {
+		t.Fatalf("expected body %q, got %q", "anyone can see this", body)
+	}
+}
@@ -0,0 +1,125 @@
+package minima
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"reflect"
+)
+
+/**
+ * @info Decodes request data into dst; the default used by Handle is a JSON body decoder
+ */
+type Binder interface {
+	Bind(req *Request, dst any) error
+}
+
+type jsonBinder struct{}
+
+func (jsonBinder) Bind(req *Request, dst any) error {
+	defer req.ref.Body.Close()
+	return json.NewDecoder(req.ref.Body).Decode(dst)
+}
+
+/**
+ * @info Implemented by a Handle destination struct that needs post-decode validation
+ */
+type Validator interface {
+	Validate() error
+}
+
+type statusCoder interface {
+	StatusCode() int
+}
+
+func writeHandleError(res *Response, err error) {
+	code := http.StatusInternalServerError
+	if sc, ok := err.(statusCoder); ok {
+		code = sc.StatusCode()
+	}
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{"code": code, "message": err.Error()},
+	})
+	res.Status(code).JSON(string(body))
+}
+
+func writeHandleResult(res *Response, result any) {
+	body, err := json.Marshal(result)
+	if err != nil {
+		writeHandleError(res, err)
+		return
+	}
+	res.Status(http.StatusOK).JSON(string(body))
+}
+
+var requestType = reflect.TypeOf((*Request)(nil))
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+/**
+ * @info Builds a plain Handler out of h, accepting a Handler, HandlerE, func(*Response,*Request)error, func(*Request)(any,error), or func(*Request,*T)(any,error)
+ * @param {*Minima} [m] The instance h is being registered on, used to reach the configured Binder
+ * @param {any} [h] The raw handler value passed to Handle
+ * @returns {Handler}
+ */
+func preCheckHandler(m *Minima, h any) Handler {
+	if handler, ok := h.(Handler); ok {
+		return handler
+	}
+	if fn, ok := h.(func(*Response, *Request)); ok {
+		return Handler(fn)
+	}
+	if fn, ok := h.(HandlerE); ok {
+		return func(res *Response, req *Request) {
+			if err := fn(res, req); err != nil {
+				m.handleError(res, req, err)
+			}
+		}
+	}
+	if fn, ok := h.(func(*Response, *Request) error); ok {
+		return preCheckHandler(m, HandlerE(fn))
+	}
+	if fn, ok := h.(func(*Request) (any, error)); ok {
+		return func(res *Response, req *Request) {
+			result, err := fn(req)
+			if err != nil {
+				m.handleError(res, req, err)
+				return
+			}
+			writeHandleResult(res, result)
+		}
+	}
+
+	t := reflect.TypeOf(h)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 2 ||
+		t.In(0) != requestType || t.In(1).Kind() != reflect.Ptr || t.Out(1) != errorType {
+		log.Panicf("minima: %T is not a handler Handle understands", h)
+	}
+
+	dstType := t.In(1).Elem()
+	fn := reflect.ValueOf(h)
+	binder := m.Config.Binder
+	if binder == nil {
+		binder = jsonBinder{}
+	}
+
+	return func(res *Response, req *Request) {
+		dst := reflect.New(dstType)
+		if err := binder.Bind(req, dst.Interface()); err != nil {
+			m.handleError(res, req, err)
+			return
+		}
+		if v, ok := dst.Interface().(Validator); ok {
+			if err := v.Validate(); err != nil {
+				m.handleError(res, req, err)
+				return
+			}
+		}
+
+		out := fn.Call([]reflect.Value{reflect.ValueOf(req), dst})
+		if err, _ := out[1].Interface().(error); err != nil {
+			m.handleError(res, req, err)
+			return
+		}
+		writeHandleResult(res, out[0].Interface())
+	}
+}
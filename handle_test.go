@@ -0,0 +1,134 @@
+package minima
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type greetRequest struct {
+	Name string `json:"name"`
+}
+
+func (g greetRequest) Validate() error {
+	if g.Name == "" {
+		return &HTTPError{Code: http.StatusBadRequest, Message: "name is required"}
+	}
+	return nil
+}
+
+func TestHandleBindsDecodesAndValidatesTypedHandlers(t *testing.T) {
+	app := New()
+	app.Handle(http.MethodPost, "/greet", func(req *Request, body *greetRequest) (any, error) {
+		return map[string]string{"greeting": "hello " + body.Name}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/greet", strings.NewReader(`{"name":"ada"}`))
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != `{"greeting":"hello ada"}` {
+		t.Fatalf("unexpected body %q", body)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("expected Content-Type application/json, got %q", ct)
+	}
+}
+
+func TestHandleRoutesValidationErrorThroughErrorHandler(t *testing.T) {
+	app := New()
+	app.Handle(http.MethodPost, "/greet", func(req *Request, body *greetRequest) (any, error) {
+		return map[string]string{"greeting": "hello " + body.Name}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/greet", strings.NewReader(`{"name":""}`))
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestHandleAcceptsRequestOnlyFunc(t *testing.T) {
+	app := New()
+	app.Handle(http.MethodGet, "/ping", func(req *Request) (any, error) {
+		return map[string]string{"pong": "true"}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != `{"pong":"true"}` {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
+func TestHandlePanicsOnUnsupportedSignature(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected preCheckHandler to panic on an unsupported handler shape")
+		}
+	}()
+	app := New()
+	app.Handle(http.MethodGet, "/bad", func(a, b, c int) {})
+}
+
+func TestHandlePanicsWhenSecondReturnIsNotError(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected preCheckHandler to panic when the second return isn't an error")
+		}
+	}()
+	app := New()
+	app.Handle(http.MethodGet, "/bad", func(req *Request, body *greetRequest) (any, string) {
+		return nil, "not an error"
+	})
+}
+
+func TestHandleAcceptsHandlerE(t *testing.T) {
+	app := New()
+	app.Handle(http.MethodPost, "/greet", HandlerE(func(res *Response, req *Request) error {
+		var body greetRequest
+		if err := json.NewDecoder(req.ref.Body).Decode(&body); err != nil {
+			return err
+		}
+		res.Status(http.StatusOK).Send("hello " + body.Name)
+		return nil
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/greet", strings.NewReader(`{"name":"ada"}`))
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != "hello ada" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
+func TestHandleAcceptsPlainFuncResponseRequestError(t *testing.T) {
+	app := New()
+	app.Handle(http.MethodGet, "/fail", func(res *Response, req *Request) error {
+		return &HTTPError{Code: http.StatusBadRequest, Message: "nope"}
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/fail", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
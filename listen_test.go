@@ -0,0 +1,162 @@
+package minima
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestListenAllAndShutdownAreRaceFree(t *testing.T) {
+	app := New()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- app.ListenAll(":0", ":0", ":0")
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(app.trackedServers()) < 3 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for all listeners to register")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := app.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("ListenAll: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("ListenAll did not return after Shutdown")
+	}
+}
+
+func TestListenUnixRemovesStaleSocket(t *testing.T) {
+	path := t.TempDir() + "/minima.sock"
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatalf("seed stale socket file: %v", err)
+	}
+
+	app := New()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.ListenUnix(path)
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(app.trackedServers()) < 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("ListenUnix never bound to %s (stale socket file not cleaned up?)", path)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := app.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if err := <-done; err != nil && err != http.ErrServerClosed {
+		t.Fatalf("ListenUnix: %v", err)
+	}
+}
+
+func TestListenGracefullyDrainsOnInterrupt(t *testing.T) {
+	app := New()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- app.ListenGracefully(":0")
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(app.trackedServers()) < 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for ListenGracefully to start listening")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	proc, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatalf("FindProcess: %v", err)
+	}
+	if err := proc.Signal(os.Interrupt); err != nil {
+		t.Fatalf("Signal: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("ListenGracefully: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("ListenGracefully did not drain and return after SIGINT")
+	}
+}
+
+func TestListenGracefullyReturnsOnExternalShutdown(t *testing.T) {
+	app := New()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- app.ListenGracefully(":0")
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(app.trackedServers()) < 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for ListenGracefully to start listening")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := app.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("ListenGracefully: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("ListenGracefully did not return after an externally triggered Shutdown")
+	}
+}
+
+func TestListenGracefullyReturnsPromptlyOnBindFailure(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+	addr := ln.Addr().String()
+
+	app := New()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.ListenGracefully(addr)
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected a bind error for an already-occupied address")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("ListenGracefully did not return promptly on a bind failure")
+	}
+}
@@ -2,15 +2,22 @@ package minima
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
 /**
  * @info The framework structure
- * @property {*http.Server} [server] The net/http stock server
- * @property {bool} [started] Whether the server has started or not
+ * @property {[]*http.Server} [servers] Every net/http stock server listening for this instance
+ * @property {bool} [started] Whether at least one listener has started
  * @property {*time.Duration} [Timeout] The router's breathing time
  * @property {*Router} [router] The core router instance running with the server
  * @property {[]Handler} [minmiddleware] The standard Minima handler stack
@@ -18,9 +25,12 @@ import (
  * @property {map[string]interface{}} [properties] The properties for the server instance
  * @property {*Config} [Config] The core config file for middlewares and router instances
  * @property {*time.Duration} [drain] The router's drain time
+ * @property {Chain} [chain] The composable middleware chain wrapping every matched route
+ * @property {sync.Mutex} [serversMu] Guards servers/started against concurrent ListenAll goroutines
  */
 type Minima struct {
-	server        *http.Server
+	servers       []*http.Server
+	serversMu     sync.Mutex
 	started       bool
 	Timeout       time.Duration
 	router        *Router
@@ -29,6 +39,7 @@ type Minima struct {
 	properties    map[string]interface{}
 	Config        *Config
 	drain         time.Duration
+	chain         Chain
 }
 
 /**
@@ -54,19 +65,156 @@ func New() *Minima {
 	}
 }
 
+func (m *Minima) track(srv *http.Server) {
+	m.serversMu.Lock()
+	defer m.serversMu.Unlock()
+	m.started = true
+	m.servers = append(m.servers, srv)
+}
+
+func (m *Minima) trackedServers() []*http.Server {
+	m.serversMu.Lock()
+	defer m.serversMu.Unlock()
+	servers := make([]*http.Server, len(m.servers))
+	copy(servers, m.servers)
+	return servers
+}
+
 /**
- * @info Starts the actual http server
+ * @info Starts the actual http server on addr; may be called more than once to listen on several addresses
  * @param {string} [addr] The port for the server instance to run on
  * @returns {error}
  */
 func (m *Minima) Listen(addr string) error {
-	if m.started {
-		log.Panicf("Minimia's instance is already running at %s.", m.server.Addr)
+	srv := &http.Server{Addr: addr, Handler: m}
+	m.track(srv)
+
+	return srv.ListenAndServe()
+}
+
+/**
+ * @info Starts a TLS listener on addr using the given certificate/key pair
+ * @param {string} [addr] The port for the server instance to run on
+ * @param {string} [certFile] Path to the PEM-encoded certificate
+ * @param {string} [keyFile] Path to the PEM-encoded private key
+ * @returns {error}
+ */
+func (m *Minima) ListenTLS(addr string, certFile string, keyFile string) error {
+	srv := &http.Server{Addr: addr, Handler: m}
+	m.track(srv)
+
+	return srv.ListenAndServeTLS(certFile, keyFile)
+}
+
+/**
+ * @info Starts a listener on a Unix domain socket
+ * @param {string} [path] The socket path to listen on
+ * @returns {error}
+ */
+func (m *Minima) ListenUnix(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
 	}
-	m.server = &http.Server{Addr: addr, Handler: m}
-	m.started = true
 
-	return m.server.ListenAndServe()
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{Handler: m}
+	m.track(srv)
+
+	return srv.Serve(ln)
+}
+
+/**
+ * @info Starts a TCP listener on every address concurrently, sharing this instance's handler
+ * @param {...string} [addrs] The addresses to listen on
+ * @returns {error}
+ */
+func (m *Minima) ListenAll(addrs ...string) error {
+	errs := make(chan error, len(addrs))
+	for _, addr := range addrs {
+		addr := addr
+		go func() {
+			errs <- m.Listen(addr)
+		}()
+	}
+
+	for range addrs {
+		if err := <-errs; err != nil && err != http.ErrServerClosed {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * @info Starts the http server and blocks until every listener is cleanly drained on SIGINT/SIGTERM
+ * @param {string} [addr] The port for the server instance to run on
+ * @returns {error}
+ */
+func (m *Minima) ListenGracefully(addr string) error {
+	srv := &http.Server{Addr: addr, Handler: m}
+	m.track(srv)
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	// stopped closes the moment ListenAndServe returns, whatever the cause:
+	// a bind error, this function's own signal-triggered Shutdown below, or
+	// Shutdown being called on another goroutine entirely (e.g. a second
+	// tracked listener). Racing it against sig lets the goroutine below exit
+	// instead of leaking when shutdown was triggered from elsewhere.
+	stopped := make(chan struct{})
+
+	// goroutineDone closes once the goroutine below has returned, on every
+	// path, so the happens-before relation with any shutdownErr send lets us
+	// read it below without blocking forever.
+	goroutineDone := make(chan struct{})
+
+	shutdownErr := make(chan error, 1)
+	go func() {
+		defer close(goroutineDone)
+
+		select {
+		case <-sig:
+		case <-stopped:
+			return
+		}
+
+		ctx := context.Background()
+		if m.drain > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, m.drain)
+			defer cancel()
+		}
+		shutdownErr <- m.Shutdown(ctx)
+	}()
+
+	err := srv.ListenAndServe()
+	close(stopped)
+	<-goroutineDone
+
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	select {
+	case err := <-shutdownErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// recoverable wraps h in Recover(), unless the instance has recovery disabled.
+func (m *Minima) recoverable(h Handler) Handler {
+	if m.Config == nil || !m.Config.DisableRecover {
+		return Recover()(h)
+	}
+	return h
 }
 
 /**
@@ -87,94 +235,145 @@ func (m *Minima) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		res := response(w, r, &m.properties)
 		req := request(r)
 		req.Params = params
+		if m.Config != nil {
+			req.trustedProxies = m.Config.TrustedProxies
+		}
 
-		m.ServeMiddleware(res, req)
-		f(res, req)
+		// m.chain (and so any WithTimeout installed via UseTimeout) must wrap
+		// ServeMiddleware too, not just f, so its deadline covers minmiddleware.
+		dispatch := m.chain.Then(Handler(func(res *Response, req *Request) {
+			m.ServeMiddleware(res, req)
+			f(res, req)
+		}))
+		m.recoverable(dispatch)(res, req)
 	} else {
 		res := response(w, r, &m.properties)
 		req := request(r)
-		if m.router.notfound != nil {
-			m.router.notfound(res, req)
-		} else {
-			w.Write([]byte("No matching route found"))
+		if m.Config != nil {
+			req.trustedProxies = m.Config.TrustedProxies
+		}
+
+		notfound := m.router.notfound
+		if notfound == nil {
+			notfound = func(res *Response, req *Request) {
+				res.Send("No matching route found")
+			}
 		}
+		m.recoverable(notfound)(res, req)
 	}
 }
 
 /**
- * @info Adds route with Get method
+ * @info Adds route with Get method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Get(path string, handler Handler) *Minima {
-	m.router.Get(path, handler)
+func (m *Minima) Get(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Get(path, Chain{}.Append(mw...).Then(handler))
 	return m
 }
 
 /**
- * @info Adds route with Put method
+ * @info Adds route with Put method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Put(path string, handler Handler) *Minima {
-	m.router.Put(path, handler)
+func (m *Minima) Put(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Put(path, Chain{}.Append(mw...).Then(handler))
 	return m
 }
 
 /**
- * @info Adds route with Options method
+ * @info Adds route with Options method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Options(path string, handler Handler) *Minima {
-	m.router.Options(path, handler)
+func (m *Minima) Options(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Options(path, Chain{}.Append(mw...).Then(handler))
 	return m
 }
 
 /**
- * @info Adds route with Head method
+ * @info Adds route with Head method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Head(path string, handler Handler) *Minima {
-	m.router.Head(path, handler)
+func (m *Minima) Head(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Head(path, Chain{}.Append(mw...).Then(handler))
 	return m
 }
 
 /**
- * @info Adds route with Delete method
+ * @info Adds route with Delete method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Delete(path string, handler Handler) *Minima {
-	m.router.Delete(path, handler)
+func (m *Minima) Delete(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Delete(path, Chain{}.Append(mw...).Then(handler))
 	return m
 }
 
 /**
- * @info Adds route with Patch method
+ * @info Adds route with Patch method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Patch(path string, handler Handler) *Minima {
-	m.router.Patch(path, handler)
+func (m *Minima) Patch(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Patch(path, Chain{}.Append(mw...).Then(handler))
 	return m
 }
 
 /**
- * @info Adds route with Post method
+ * @info Adds route with Post method, optionally wrapped with per-route Constructors (e.g. WithTimeout)
  * @param {string} [path] The route path
- * @param {...Handler} [handler] The handler for the given route
+ * @param {Handler} [handler] The handler for the given route
+ * @param {...Constructor} [mw] Constructors wrapping just this route, outermost first
  * @returns {*Minima}
  */
-func (m *Minima) Post(path string, handler Handler) *Minima {
-	m.router.Post(path, handler)
+func (m *Minima) Post(path string, handler Handler, mw ...Constructor) *Minima {
+	m.router.Post(path, Chain{}.Append(mw...).Then(handler))
+	return m
+}
+
+/**
+ * @info Adds route with an automatically adapted typed handler
+ * @param {string} [method] The HTTP method to register the route under
+ * @param {string} [path] The route path
+ * @param {any} [h] A Handler, a HandlerE (or plain func(*Response, *Request) error), a func(*Request) (any, error), or a func(*Request, *T) (any, error)
+ * @returns {*Minima}
+ */
+func (m *Minima) Handle(method string, path string, h any) *Minima {
+	handler := preCheckHandler(m, h)
+	switch strings.ToUpper(method) {
+	case http.MethodGet:
+		m.router.Get(path, handler)
+	case http.MethodPost:
+		m.router.Post(path, handler)
+	case http.MethodPut:
+		m.router.Put(path, handler)
+	case http.MethodPatch:
+		m.router.Patch(path, handler)
+	case http.MethodDelete:
+		m.router.Delete(path, handler)
+	case http.MethodHead:
+		m.router.Head(path, handler)
+	case http.MethodOptions:
+		m.router.Options(path, handler)
+	default:
+		log.Panicf("minima: unsupported method %q", method)
+	}
 	return m
 }
 
@@ -234,13 +433,19 @@ func (m *Minima) ShutdownTimeout(t time.Duration) *Minima {
 }
 
 /**
- * @info Shutdowns the core instance
+ * @info Shutdowns every listener tracked by the core instance, draining all of them even if one fails
  * @param {context.Context} [ctx] The context for shutdown
- * @returns {error}
+ * @returns {error} The joined errors from every listener that failed to shut down, or nil
  */
 func (m *Minima) Shutdown(ctx context.Context) error {
 	log.Println("Stopping the server")
-	return m.server.Shutdown(ctx)
+	var errs []error
+	for _, srv := range m.trackedServers() {
+		if err := srv.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 /**
@@ -281,6 +486,16 @@ func (m *Minima) UseRaw(handler ...http.HandlerFunc) {
 	m.rawmiddleware = append(m.rawmiddleware, handler...)
 }
 
+/**
+ * @info Extends the Minima instance's middleware Chain, wrapping every matched route
+ * @param {Chain} [chain] The chain of constructors to append
+ * @returns {*Minima}
+ */
+func (m *Minima) UseChain(chain Chain) *Minima {
+	m.chain = m.chain.Append(chain.constructors...)
+	return m
+}
+
 /**
  * @info Serves and injects the middlewares to Minima logic
  * @param {Response} [res] The Minima response instance
@@ -288,15 +503,9 @@ func (m *Minima) UseRaw(handler ...http.HandlerFunc) {
  * @returns {}
  */
 func (m *Minima) ServeMiddleware(res *Response, req *Request) {
-	if len(m.rawmiddleware) == 0 {
-		return
-	}
 	for _, raw := range m.rawmiddleware {
 		raw(res.ref, req.ref)
 	}
-	if len(m.minmiddleware) == 0 {
-		return
-	}
 	for _, min := range m.minmiddleware {
 		min(res, req)
 	}
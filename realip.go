@@ -0,0 +1,60 @@
+package minima
+
+import (
+	"net"
+	"strings"
+)
+
+// stripPort returns host with any trailing ":port" removed, falling back to
+// the raw value when it isn't a valid host:port pair (e.g. a bare IP).
+func stripPort(hostport string) string {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return hostport
+	}
+	return host
+}
+
+func (req *Request) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, cidr := range req.trustedProxies {
+		if cidr.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * @info Returns the client's address, honoring X-Forwarded-For/X-Real-IP only from trusted proxies
+ * @returns {string}
+ */
+func (req *Request) RealIP() string {
+	host := stripPort(req.ref.RemoteAddr)
+
+	if len(req.trustedProxies) == 0 || !req.isTrustedProxy(host) {
+		return host
+	}
+
+	if xff := req.ref.Header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			candidate := stripPort(strings.TrimSpace(hops[i]))
+			if candidate == "" {
+				continue
+			}
+			if !req.isTrustedProxy(candidate) {
+				return candidate
+			}
+		}
+	}
+
+	if realIP := req.ref.Header.Get("X-Real-IP"); realIP != "" {
+		return stripPort(realIP)
+	}
+
+	return host
+}
@@ -0,0 +1,92 @@
+package minima
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+)
+
+func trustedCIDR(t *testing.T, cidr string) *net.IPNet {
+	t.Helper()
+	_, net, err := net.ParseCIDR(cidr)
+	if err != nil {
+		t.Fatalf("ParseCIDR(%q): %v", cidr, err)
+	}
+	return net
+}
+
+func TestRealIPIgnoresHeadersFromUntrustedPeer(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "203.0.113.7:1234"
+	r.Header.Set("X-Forwarded-For", "10.0.0.1")
+
+	req := request(r)
+	req.trustedProxies = []*net.IPNet{trustedCIDR(t, "127.0.0.1/32")}
+
+	if got := req.RealIP(); got != "203.0.113.7" {
+		t.Fatalf("expected the untrusted peer's own address, got %q", got)
+	}
+}
+
+func TestRealIPWalksXForwardedForPastTrustedHops(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "198.51.100.9, 10.0.0.2, 10.0.0.1")
+
+	req := request(r)
+	req.trustedProxies = []*net.IPNet{trustedCIDR(t, "10.0.0.0/8"), trustedCIDR(t, "127.0.0.1/32")}
+
+	if got := req.RealIP(); got != "198.51.100.9" {
+		t.Fatalf("expected the first untrusted hop, got %q", got)
+	}
+}
+
+func TestRealIPFallsBackToXRealIP(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("X-Real-IP", "198.51.100.9")
+
+	req := request(r)
+	req.trustedProxies = []*net.IPNet{trustedCIDR(t, "127.0.0.1/32")}
+
+	if got := req.RealIP(); got != "198.51.100.9" {
+		t.Fatalf("expected X-Real-IP value, got %q", got)
+	}
+}
+
+func TestRealIPStripsPortFromForwardedHopsAndXRealIP(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "198.51.100.9:5678, 10.0.0.2:443, 10.0.0.1:443")
+
+	req := request(r)
+	req.trustedProxies = []*net.IPNet{trustedCIDR(t, "10.0.0.0/8"), trustedCIDR(t, "127.0.0.1/32")}
+
+	if got := req.RealIP(); got != "198.51.100.9" {
+		t.Fatalf("expected the first untrusted hop with its port stripped, got %q", got)
+	}
+
+	r2 := httptest.NewRequest("GET", "/", nil)
+	r2.RemoteAddr = "127.0.0.1:1234"
+	r2.Header.Set("X-Real-IP", "198.51.100.9:5678")
+
+	req2 := request(r2)
+	req2.trustedProxies = []*net.IPNet{trustedCIDR(t, "127.0.0.1/32")}
+
+	if got := req2.RealIP(); got != "198.51.100.9" {
+		t.Fatalf("expected X-Real-IP with its port stripped, got %q", got)
+	}
+}
+
+func TestRealIPFallsBackToRemoteAddrWhenEveryHopIsTrusted(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "10.0.0.2, 10.0.0.1")
+
+	req := request(r)
+	req.trustedProxies = []*net.IPNet{trustedCIDR(t, "10.0.0.0/8"), trustedCIDR(t, "127.0.0.1/32")}
+
+	if got := req.RealIP(); got != "127.0.0.1" {
+		t.Fatalf("expected RemoteAddr fallback, got %q", got)
+	}
+}
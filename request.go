@@ -0,0 +1,22 @@
+package minima
+
+import (
+	"net"
+	"net/http"
+)
+
+/**
+ * @info The Minima request wrapper around the stock net/http request
+ * @property {*http.Request} [ref] The underlying net/http request
+ * @property {map[string]string} [Params] The path params bound by the route trie
+ * @property {[]*net.IPNet} [trustedProxies] The CIDRs RealIP trusts to set X-Forwarded-For/X-Real-IP
+ */
+type Request struct {
+	ref            *http.Request
+	Params         map[string]string
+	trustedProxies []*net.IPNet
+}
+
+func request(r *http.Request) *Request {
+	return &Request{ref: r}
+}
@@ -0,0 +1,60 @@
+package minima
+
+import "net/http"
+
+/**
+ * @info The Minima response wrapper around the stock net/http response writer
+ * @property {http.ResponseWriter} [ref] The underlying net/http response writer
+ * @property {*http.Request} [req] The underlying net/http request, kept for context access
+ * @property {*map[string]interface{}} [properties] The server-wide properties, shared by reference
+ * @property {int} [statusCode] The status code queued by Status, written on the first Send/JSON
+ * @property {bool} [written] Whether the status line has already been written
+ */
+type Response struct {
+	ref        http.ResponseWriter
+	req        *http.Request
+	properties *map[string]interface{}
+	statusCode int
+	written    bool
+}
+
+func response(w http.ResponseWriter, r *http.Request, properties *map[string]interface{}) *Response {
+	return &Response{ref: w, req: r, properties: properties, statusCode: http.StatusOK}
+}
+
+/**
+ * @info Queues the status code to be written with the next Send/JSON call
+ * @param {int} [code] The HTTP status code
+ * @returns {*Response}
+ */
+func (res *Response) Status(code int) *Response {
+	res.statusCode = code
+	return res
+}
+
+func (res *Response) writeHeader() {
+	if !res.written {
+		res.ref.WriteHeader(res.statusCode)
+		res.written = true
+	}
+}
+
+/**
+ * @info Writes body as the response, flushing the queued status code first
+ * @param {string} [body] The response body
+ * @returns {}
+ */
+func (res *Response) Send(body string) {
+	res.writeHeader()
+	res.ref.Write([]byte(body))
+}
+
+/**
+ * @info Writes body as a JSON response, setting Content-Type before flushing the queued status code
+ * @param {string} [body] The JSON-encoded response body
+ * @returns {}
+ */
+func (res *Response) JSON(body string) {
+	res.ref.Header().Set("Content-Type", "application/json")
+	res.Send(body)
+}
@@ -0,0 +1,149 @@
+package minima
+
+import "net/http"
+
+/**
+ * @info The core handler signature every route and middleware is built from
+ */
+type Handler func(res *Response, req *Request)
+
+/**
+ * @info The core router, holding one path trie per HTTP method
+ * @property {map[string]*trie} [routes] The per-method route tries
+ * @property {Handler} [notfound] The handler run when no route matches
+ */
+type Router struct {
+	routes   map[string]*trie
+	notfound Handler
+}
+
+var routerMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodHead,
+	http.MethodOptions,
+}
+
+/**
+ * @info Makes a new empty Router with a trie pre-built for every HTTP method
+ * @returns {*Router}
+ */
+func NewRouter() *Router {
+	r := &Router{routes: make(map[string]*trie, len(routerMethods))}
+	for _, method := range routerMethods {
+		r.routes[method] = newTrie()
+	}
+	return r
+}
+
+/**
+ * @info Adds route with Get method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Get(path string, handler Handler) {
+	r.routes[http.MethodGet].Add(path, handler)
+}
+
+/**
+ * @info Adds route with Post method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Post(path string, handler Handler) {
+	r.routes[http.MethodPost].Add(path, handler)
+}
+
+/**
+ * @info Adds route with Put method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Put(path string, handler Handler) {
+	r.routes[http.MethodPut].Add(path, handler)
+}
+
+/**
+ * @info Adds route with Patch method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Patch(path string, handler Handler) {
+	r.routes[http.MethodPatch].Add(path, handler)
+}
+
+/**
+ * @info Adds route with Delete method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Delete(path string, handler Handler) {
+	r.routes[http.MethodDelete].Add(path, handler)
+}
+
+/**
+ * @info Adds route with Head method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Head(path string, handler Handler) {
+	r.routes[http.MethodHead].Add(path, handler)
+}
+
+/**
+ * @info Adds route with Options method
+ * @param {string} [path] The route path
+ * @param {Handler} [handler] The handler for the given route
+ * @returns {}
+ */
+func (r *Router) Options(path string, handler Handler) {
+	r.routes[http.MethodOptions].Add(path, handler)
+}
+
+/**
+ * @info Injects the NotFound handler to the Router instance
+ * @param {Handler} [handler] The handler to run when no route matches
+ * @returns {}
+ */
+func (r *Router) NotFound(handler Handler) {
+	r.notfound = handler
+}
+
+/**
+ * @info Merges every route from another router into this one
+ * @param {*Router} [router] The router whose routes should be merged in
+ * @returns {}
+ */
+func (r *Router) UseRouter(router *Router) {
+	for method, t := range router.routes {
+		t.Walk(func(path string, handler Handler) {
+			r.routes[method].Add(path, handler)
+		})
+	}
+	if router.notfound != nil {
+		r.notfound = router.notfound
+	}
+}
+
+/**
+ * @info Mounts every route from router under path
+ * @param {string} [path] The path prefix routes should be mounted under
+ * @param {*Router} [router] The router to mount
+ * @returns {}
+ */
+func (r *Router) Mount(path string, router *Router) {
+	for method, t := range router.routes {
+		t.Walk(func(subpath string, handler Handler) {
+			r.routes[method].Add(path+subpath, handler)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package minima
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// timeoutCTWriter sets Content-Type to application/json on any 503 written
+// without one, which covers the synthetic 503 http.TimeoutHandler writes once
+// the deadline fires. Other statuses, and responses that already carry a
+// Content-Type, are left alone; a 503 the handler itself wrote without a
+// Content-Type is labelled application/json as well.
+type timeoutCTWriter struct {
+	http.ResponseWriter
+}
+
+func (w timeoutCTWriter) WriteHeader(code int) {
+	if code == http.StatusServiceUnavailable && w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+/**
+ * @info Builds a Constructor that cancels the wrapped handler's context and replies with a JSON 503 once d elapses
+ * @param {time.Duration} [d] The deadline a handler is allowed to run for
+ * @param {string} [msg] The message reported in the timeout body
+ * @returns {Constructor}
+ */
+func WithTimeout(d time.Duration, msg string) Constructor {
+	body := fmt.Sprintf(`{"error":{"code":503,"message":%q}}`, msg)
+	return func(next Handler) Handler {
+		return func(res *Response, req *Request) {
+			inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				innerReq := request(r)
+				innerReq.Params = req.Params
+				innerReq.trustedProxies = req.trustedProxies
+				next(response(w, r, res.properties), innerReq)
+			})
+			http.TimeoutHandler(inner, d, body).ServeHTTP(timeoutCTWriter{res.ref}, req.ref)
+		}
+	}
+}
+
+/**
+ * @info Wires the global request timeout, wrapping every matched route via http.TimeoutHandler
+ * @param {time.Duration} [d] The deadline a handler is allowed to run for
+ * @param {string} [msg] The message reported in the timeout body
+ * @returns {*Minima}
+ */
+func (m *Minima) UseTimeout(d time.Duration, msg string) *Minima {
+	m.Timeout = d
+	return m.UseChain(NewChain(WithTimeout(d, msg)))
+}
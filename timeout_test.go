@@ -0,0 +1,96 @@
+package minima
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithTimeoutReturns503JSONOnDeadline(t *testing.T) {
+	app := New()
+	app.Get("/slow", func(res *Response, req *Request) {
+		time.Sleep(50 * time.Millisecond)
+		res.Send("too late")
+	}, WithTimeout(time.Millisecond, "request timed out"))
+
+	srv := httptest.NewServer(app)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + "/slow")
+	if err != nil {
+		t.Fatalf("GET /slow: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("expected Content-Type application/json, got %q", ct)
+	}
+}
+
+func TestWithTimeoutLeavesFastHandlerUntouched(t *testing.T) {
+	app := New()
+	app.Get("/fast", func(res *Response, req *Request) {
+		res.Send("ok")
+	}, WithTimeout(time.Second, "request timed out"))
+
+	req := httptest.NewRequest(http.MethodGet, "/fast", nil)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != "ok" {
+		t.Fatalf("expected body %q, got %q", "ok", body)
+	}
+}
+
+func TestUseTimeoutAppliesGlobally(t *testing.T) {
+	app := New()
+	app.UseTimeout(time.Millisecond, "request timed out")
+	app.Get("/slow", func(res *Response, req *Request) {
+		time.Sleep(50 * time.Millisecond)
+		res.Send("too late")
+	})
+
+	srv := httptest.NewServer(app)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + "/slow")
+	if err != nil {
+		t.Fatalf("GET /slow: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", resp.StatusCode)
+	}
+}
+
+func TestUseTimeoutCoversMinMiddleware(t *testing.T) {
+	app := New()
+	app.UseTimeout(time.Millisecond, "request timed out")
+	app.Use(func(res *Response, req *Request) {
+		time.Sleep(50 * time.Millisecond)
+	})
+	app.Get("/ping", func(res *Response, req *Request) {
+		res.Send("pong")
+	})
+
+	srv := httptest.NewServer(app)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + "/ping")
+	if err != nil {
+		t.Fatalf("GET /ping: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected a slow minmiddleware to be covered by UseTimeout's deadline, got status %d", resp.StatusCode)
+	}
+}
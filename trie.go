@@ -0,0 +1,121 @@
+package minima
+
+import "strings"
+
+/**
+ * @info A single node of the route trie, keyed by path segment
+ * @property {map[string]*trieNode} [children] Static child segments
+ * @property {*trieNode} [param] The dynamic (":name") child, if any
+ * @property {string} [paramName] The name bound for the dynamic child
+ * @property {Handler} [handler] The handler registered at this node, if terminal
+ * @property {bool} [terminal] Whether a route actually ends at this node
+ */
+type trieNode struct {
+	children  map[string]*trieNode
+	param     *trieNode
+	paramName string
+	handler   Handler
+	terminal  bool
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[string]*trieNode)}
+}
+
+/**
+ * @info A per-method route trie mapping paths (with optional ":param" segments) to handlers
+ * @property {*trieNode} [root] The root of the trie
+ */
+type trie struct {
+	root *trieNode
+}
+
+func newTrie() *trie {
+	return &trie{root: newTrieNode()}
+}
+
+func splitPath(path string) []string {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return []string{}
+	}
+	return strings.Split(trimmed, "/")
+}
+
+/**
+ * @info Registers a handler for the given path, creating segments as needed
+ * @param {string} [path] The route path, may contain ":name" segments
+ * @param {Handler} [handler] The handler to run for this path
+ * @returns {}
+ */
+func (t *trie) Add(path string, handler Handler) {
+	node := t.root
+	for _, segment := range splitPath(path) {
+		if strings.HasPrefix(segment, ":") {
+			if node.param == nil {
+				node.param = newTrieNode()
+				node.param.paramName = segment[1:]
+			}
+			node = node.param
+		} else {
+			child, ok := node.children[segment]
+			if !ok {
+				child = newTrieNode()
+				node.children[segment] = child
+			}
+			node = child
+		}
+	}
+	node.handler = handler
+	node.terminal = true
+}
+
+/**
+ * @info Looks up the handler registered for path, collecting any bound params
+ * @param {string} [path] The incoming request path
+ * @returns {Handler, map[string]string, bool}
+ */
+func (t *trie) Get(path string) (Handler, map[string]string, bool) {
+	node := t.root
+	var params map[string]string
+	for _, segment := range splitPath(path) {
+		if child, ok := node.children[segment]; ok {
+			node = child
+			continue
+		}
+		if node.param != nil {
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[node.param.paramName] = segment
+			node = node.param
+			continue
+		}
+		return nil, nil, false
+	}
+	if !node.terminal {
+		return nil, nil, false
+	}
+	return node.handler, params, true
+}
+
+/**
+ * @info Walks every terminal route in the trie, invoking fn with its full path and handler
+ * @param {func(string, Handler)} [fn] Callback invoked once per registered route
+ * @returns {}
+ */
+func (t *trie) Walk(fn func(path string, handler Handler)) {
+	t.walk(t.root, "", fn)
+}
+
+func (t *trie) walk(node *trieNode, prefix string, fn func(path string, handler Handler)) {
+	if node.terminal {
+		fn(prefix, node.handler)
+	}
+	for segment, child := range node.children {
+		t.walk(child, prefix+"/"+segment, fn)
+	}
+	if node.param != nil {
+		t.walk(node.param, prefix+"/:"+node.param.paramName, fn)
+	}
+}